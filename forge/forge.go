@@ -0,0 +1,75 @@
+// Package forge defines the backend-agnostic interface gh-self-reviewer uses to talk to a
+// Git forge (GitHub, Gitea, GitLab, ...). MCP tool signatures in main and gh stay the same
+// regardless of which Client implementation is wired up.
+package forge
+
+import "context"
+
+// PR represents a pull (or merge) request authored by the authenticated user.
+type PR struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	Base      string `json:"base"`
+	Head      string `json:"head"`
+	RepoOwner string `json:"repo_owner"`
+	RepoName  string `json:"repo_name"`
+}
+
+// PRFile represents a single changed file in a pull request.
+type PRFile struct {
+	Filename    string `json:"filename"`
+	Status      string `json:"status"`
+	Additions   int    `json:"additions"`
+	Deletions   int    `json:"deletions"`
+	Changes     int    `json:"changes"`
+	Patch       string `json:"patch,omitempty"`
+	BlobURL     string `json:"blob_url"`
+	ContentsURL string `json:"contents_url"`
+}
+
+// PRContent represents the complete content of a pull request.
+type PRContent struct {
+	PR          PR       `json:"pr"`
+	Files       []PRFile `json:"files"`
+	Description string   `json:"description"`
+}
+
+// InlineComment represents a single line-anchored comment to attach to a review.
+type InlineComment struct {
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	Side      string `json:"side,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	Body      string `json:"body"`
+}
+
+// ReviewOptions carries the optional parts of SubmitReview: the review event
+// (COMMENT/APPROVE/REQUEST_CHANGES, where supported) and any inline comments.
+type ReviewOptions struct {
+	Event    string
+	Comments []InlineComment
+}
+
+// Review represents a submitted review.
+type Review struct {
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+// Client is the set of operations gh-self-reviewer needs from a Git forge. Each forge
+// (GitHub, Gitea, GitLab, ...) provides its own implementation; the MCP tools in main
+// only ever talk to this interface.
+type Client interface {
+	// ListMyOpenPRs lists open PRs/MRs authored by the authenticated user across repos.
+	ListMyOpenPRs(ctx context.Context) ([]PR, error)
+
+	// GetPRContents retrieves the content of the PR/MR identified by url.
+	GetPRContents(ctx context.Context, url string) (*PRContent, error)
+
+	// SubmitReview submits a review on the PR/MR identified by url. opts may be nil.
+	SubmitReview(ctx context.Context, url string, body string, opts *ReviewOptions) (*Review, error)
+
+	// ParsePRURL extracts the owner, repo, and PR/MR number from a forge PR URL.
+	ParsePRURL(url string) (owner, repo string, number int, err error)
+}
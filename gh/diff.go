@@ -0,0 +1,185 @@
+package gh
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DiffLine is a single line within a Hunk. Kind is one of "context", "addition", or
+// "deletion". OldLineNo/NewLineNo are the absolute line numbers in the old/new version of
+// the file respectively; a deletion has no NewLineNo and an addition has no OldLineNo.
+type DiffLine struct {
+	Kind      string `json:"kind"`
+	Content   string `json:"content"`
+	OldLineNo int    `json:"old_line_no,omitempty"`
+	NewLineNo int    `json:"new_line_no,omitempty"`
+}
+
+// Hunk is a contiguous block of changed lines, anchored to absolute line numbers in both
+// the old and new versions of the file.
+type Hunk struct {
+	OldStart int        `json:"old_start"`
+	OldLines int        `json:"old_lines"`
+	NewStart int        `json:"new_start"`
+	NewLines int        `json:"new_lines"`
+	Lines    []DiffLine `json:"lines"`
+}
+
+// FileDiff is the parsed diff for a single file, giving the LLM stable line numbers to
+// anchor inline review comments to (the raw Patch string on GitHubPRFile has none, and
+// GitHub omits it outright for large files).
+type FileDiff struct {
+	Path        string `json:"path"`
+	OldPath     string `json:"old_path,omitempty"`
+	Hunks       []Hunk `json:"hunks"`
+	FullContent string `json:"full_content,omitempty"`
+}
+
+// PRDiff is the parsed, semantic diff for a pull request.
+type PRDiff struct {
+	PR    GitHubPR   `json:"pr"`
+	Files []FileDiff `json:"files"`
+}
+
+const hunkHeaderPrefix = "@@ "
+
+// parseUnifiedDiff parses the `application/vnd.github.v3.diff` body of a PR into
+// per-file hunks with absolute old/new line numbers.
+func parseUnifiedDiff(raw string) ([]FileDiff, error) {
+	var files []FileDiff
+	var current *FileDiff
+	var hunk *Hunk
+	var oldLine, newLine int
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			if current != nil {
+				files = append(files, finalizeFileDiff(*current))
+			}
+			current = &FileDiff{}
+			hunk = nil
+
+		// "--- "/"+++ " only mark the old/new file header immediately after a "diff --git"
+		// line, before any hunk has started. Once hunk != nil, a line starting with those
+		// same characters is hunk content (e.g. a deleted "-- some comment" line becomes the
+		// raw diff line "--- some comment") and must fall through to the content cases below.
+		case hunk == nil && strings.HasPrefix(line, "--- "):
+			if current == nil {
+				return nil, fmt.Errorf("old-file header before any diff header: %q", line)
+			}
+			current.OldPath = strings.TrimPrefix(strings.TrimPrefix(line, "--- a/"), "--- ")
+
+		case hunk == nil && strings.HasPrefix(line, "+++ "):
+			if current == nil {
+				return nil, fmt.Errorf("new-file header before any diff header: %q", line)
+			}
+			current.Path = strings.TrimPrefix(strings.TrimPrefix(line, "+++ b/"), "+++ ")
+
+		case strings.HasPrefix(line, hunkHeaderPrefix):
+			if current == nil {
+				return nil, fmt.Errorf("hunk header before any file header: %q", line)
+			}
+			h, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, err
+			}
+			current.Hunks = append(current.Hunks, h)
+			hunk = &current.Hunks[len(current.Hunks)-1]
+			oldLine = h.OldStart
+			newLine = h.NewStart
+
+		case hunk != nil && strings.HasPrefix(line, "+"):
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: "addition", Content: line[1:], NewLineNo: newLine})
+			newLine++
+
+		case hunk != nil && strings.HasPrefix(line, "-"):
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: "deletion", Content: line[1:], OldLineNo: oldLine})
+			oldLine++
+
+		case hunk != nil && strings.HasPrefix(line, " "):
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: "context", Content: line[1:], OldLineNo: oldLine, NewLineNo: newLine})
+			oldLine++
+			newLine++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan diff: %w", err)
+	}
+	if current != nil {
+		files = append(files, finalizeFileDiff(*current))
+	}
+	return files, nil
+}
+
+// finalizeFileDiff applies post-parse fixups to a fully-parsed file entry. A deleted file's
+// "+++ " header reads "/dev/null" rather than a real path, so Path falls back to OldPath in
+// that case — otherwise a deleted file's removed lines have no usable path to anchor an
+// inline review comment to.
+func finalizeFileDiff(f FileDiff) FileDiff {
+	if f.Path == "/dev/null" {
+		f.Path = f.OldPath
+	}
+	return f
+}
+
+// ParseUnifiedDiff parses a raw unified diff (as produced by GitHub's
+// application/vnd.github.v3.diff media type, or the equivalent from other forges) into
+// per-file hunks with absolute old/new line numbers. Exported so other forge backends
+// (e.g. gitea) can reuse it instead of duplicating a diff parser.
+func ParseUnifiedDiff(raw string) ([]FileDiff, error) {
+	return parseUnifiedDiff(raw)
+}
+
+// parseHunkHeader parses a line like "@@ -12,7 +12,9 @@ func foo() {" into its old/new
+// start/line counts.
+func parseHunkHeader(line string) (Hunk, error) {
+	body := strings.TrimPrefix(line, hunkHeaderPrefix)
+	end := strings.Index(body, " @@")
+	if end == -1 {
+		return Hunk{}, fmt.Errorf("malformed hunk header: %q", line)
+	}
+
+	ranges := strings.Fields(body[:end])
+	if len(ranges) != 2 {
+		return Hunk{}, fmt.Errorf("malformed hunk header: %q", line)
+	}
+
+	oldStart, oldLines, err := parseHunkRange(ranges[0], "-")
+	if err != nil {
+		return Hunk{}, fmt.Errorf("malformed old range in hunk header %q: %w", line, err)
+	}
+	newStart, newLines, err := parseHunkRange(ranges[1], "+")
+	if err != nil {
+		return Hunk{}, fmt.Errorf("malformed new range in hunk header %q: %w", line, err)
+	}
+
+	return Hunk{OldStart: oldStart, OldLines: oldLines, NewStart: newStart, NewLines: newLines}, nil
+}
+
+// parseHunkRange parses "-12,7" or "+12,9" (the ",N" length is optional, defaulting to 1).
+func parseHunkRange(rangeStr, sign string) (start, lines int, err error) {
+	rangeStr = strings.TrimPrefix(rangeStr, sign)
+	parts := strings.SplitN(rangeStr, ",", 2)
+
+	start, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lines = 1
+	if len(parts) == 2 {
+		lines, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return start, lines, nil
+}
@@ -0,0 +1,193 @@
+package gh
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []FileDiff
+	}{
+		{
+			name: "additions, deletions, and context",
+			raw: `diff --git a/foo.go b/foo.go
+index 1111111..2222222 100644
+--- a/foo.go
++++ b/foo.go
+@@ -1,3 +1,4 @@
+ package foo
+-func old() {}
++func new() {}
++func extra() {}
+`,
+			want: []FileDiff{
+				{
+					Path:    "foo.go",
+					OldPath: "foo.go",
+					Hunks: []Hunk{
+						{
+							OldStart: 1, OldLines: 3, NewStart: 1, NewLines: 4,
+							Lines: []DiffLine{
+								{Kind: "context", Content: "package foo", OldLineNo: 1, NewLineNo: 1},
+								{Kind: "deletion", Content: "func old() {}", OldLineNo: 2},
+								{Kind: "addition", Content: "func new() {}", NewLineNo: 2},
+								{Kind: "addition", Content: "func extra() {}", NewLineNo: 3},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "deleted file falls back to old path",
+			raw: `diff --git a/bar.go b/bar.go
+deleted file mode 100644
+index 1111111..0000000
+--- a/bar.go
++++ /dev/null
+@@ -1,2 +0,0 @@
+-line one
+-line two
+`,
+			want: []FileDiff{
+				{
+					Path:    "bar.go",
+					OldPath: "bar.go",
+					Hunks: []Hunk{
+						{
+							OldStart: 1, OldLines: 2, NewStart: 0, NewLines: 0,
+							Lines: []DiffLine{
+								{Kind: "deletion", Content: "line one", OldLineNo: 1},
+								{Kind: "deletion", Content: "line two", OldLineNo: 2},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "renamed file with content change",
+			raw: `diff --git a/old_name.go b/new_name.go
+similarity index 88%
+rename from old_name.go
+rename to new_name.go
+index 1111111..2222222 100644
+--- a/old_name.go
++++ b/new_name.go
+@@ -1,2 +1,3 @@
+ package foo
++// comment
+ func Foo() {}
+`,
+			want: []FileDiff{
+				{
+					Path:    "new_name.go",
+					OldPath: "old_name.go",
+					Hunks: []Hunk{
+						{
+							OldStart: 1, OldLines: 2, NewStart: 1, NewLines: 3,
+							Lines: []DiffLine{
+								{Kind: "context", Content: "package foo", OldLineNo: 1, NewLineNo: 1},
+								{Kind: "addition", Content: "// comment", NewLineNo: 2},
+								{Kind: "context", Content: "func Foo() {}", OldLineNo: 2, NewLineNo: 3},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "content lines colliding with header prefixes",
+			raw: `diff --git a/markers.go b/markers.go
+--- a/markers.go
++++ b/markers.go
+@@ -1,2 +1,2 @@
+--- reserved
++++ reserved
+`,
+			want: []FileDiff{
+				{
+					Path:    "markers.go",
+					OldPath: "markers.go",
+					Hunks: []Hunk{
+						{
+							OldStart: 1, OldLines: 2, NewStart: 1, NewLines: 2,
+							Lines: []DiffLine{
+								{Kind: "deletion", Content: "-- reserved", OldLineNo: 1},
+								{Kind: "addition", Content: "++ reserved", NewLineNo: 1},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseUnifiedDiff(tt.raw)
+			if err != nil {
+				t.Fatalf("parseUnifiedDiff() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseUnifiedDiff() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHunkHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    Hunk
+		wantErr bool
+	}{
+		{
+			name: "explicit counts",
+			line: "@@ -12,7 +12,9 @@ func foo() {",
+			want: Hunk{OldStart: 12, OldLines: 7, NewStart: 12, NewLines: 9},
+		},
+		{
+			name: "default single-line counts",
+			line: "@@ -5 +6 @@",
+			want: Hunk{OldStart: 5, OldLines: 1, NewStart: 6, NewLines: 1},
+		},
+		{
+			name:    "missing closing @@",
+			line:    "@@ -1,2 +1,2",
+			wantErr: true,
+		},
+		{
+			name:    "malformed range",
+			line:    "@@ -1,2 @@",
+			wantErr: true,
+		},
+		{
+			name:    "non-numeric range",
+			line:    "@@ -a,2 +1,2 @@",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseHunkHeader(tt.line)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseHunkHeader() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseHunkHeader() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseHunkHeader() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
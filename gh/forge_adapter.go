@@ -0,0 +1,102 @@
+package gh
+
+import (
+	"context"
+
+	"github.com/alesr/gh-self-reviewer/forge"
+)
+
+var _ forge.Client = (*GithubToolHandler)(nil)
+
+// The methods below adapt GithubToolHandler to the forge.Client interface so the GitHub
+// backend can be selected the same way as any other forge (Gitea, GitLab, ...).
+
+// ListMyOpenPRs implements forge.Client.
+func (h *GithubToolHandler) ListMyOpenPRs(ctx context.Context) ([]forge.PR, error) {
+	prs, err := h.ListMyOpenPullRequestsAcrossRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	forgePRs := make([]forge.PR, 0, len(prs))
+	for _, pr := range prs {
+		forgePRs = append(forgePRs, forge.PR{
+			Number:    pr.Number,
+			Title:     pr.Title,
+			URL:       pr.URL,
+			Base:      pr.Base,
+			Head:      pr.Head,
+			RepoOwner: pr.RepoOwner,
+			RepoName:  pr.RepoName,
+		})
+	}
+	return forgePRs, nil
+}
+
+// GetPRContents implements forge.Client.
+func (h *GithubToolHandler) GetPRContents(ctx context.Context, url string) (*forge.PRContent, error) {
+	content, err := h.GetPullRequestContents(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	forgeFiles := make([]forge.PRFile, 0, len(content.Files))
+	for _, f := range content.Files {
+		forgeFiles = append(forgeFiles, forge.PRFile{
+			Filename:    f.Filename,
+			Status:      f.Status,
+			Additions:   f.Additions,
+			Deletions:   f.Deletions,
+			Changes:     f.Changes,
+			Patch:       f.Patch,
+			BlobURL:     f.BlobURL,
+			ContentsURL: f.ContentsURL,
+		})
+	}
+
+	return &forge.PRContent{
+		PR: forge.PR{
+			Number:    content.PR.Number,
+			Title:     content.PR.Title,
+			URL:       content.PR.URL,
+			Base:      content.PR.Base,
+			Head:      content.PR.Head,
+			RepoOwner: content.PR.RepoOwner,
+			RepoName:  content.PR.RepoName,
+		},
+		Files:       forgeFiles,
+		Description: content.Description,
+	}, nil
+}
+
+// SubmitReview implements forge.Client.
+func (h *GithubToolHandler) SubmitReview(ctx context.Context, url string, body string, opts *forge.ReviewOptions) (*forge.Review, error) {
+	var event string
+	var comments []InlineComment
+	if opts != nil {
+		event = opts.Event
+		for _, c := range opts.Comments {
+			comments = append(comments, InlineComment{
+				Path:      c.Path,
+				Line:      c.Line,
+				Side:      c.Side,
+				StartLine: c.StartLine,
+				Body:      c.Body,
+			})
+		}
+	}
+
+	review, err := h.SubmitPullRequestReview(ctx, url, body, event, comments)
+	if err != nil {
+		return nil, err
+	}
+	return &forge.Review{
+		Body:    review.Body,
+		HTMLURL: review.HTMLURL,
+	}, nil
+}
+
+// ParsePRURL implements forge.Client.
+func (h *GithubToolHandler) ParsePRURL(url string) (owner, repo string, number int, err error) {
+	return parsePullRequestURL(url)
+}
@@ -2,12 +2,19 @@ package gh
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v52/github"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/alesr/gh-self-reviewer/store"
 )
 
 // GitHubPR represents the structure of a GitHub Pull Request.
@@ -32,6 +39,23 @@ type PRReviewSubmitRequest struct {
 	ReviewBody string `json:"review_body" jsonschema:"required,description=Content of the review to submit"`
 }
 
+// InlineComment represents a single line-anchored comment to attach to a PR review.
+type InlineComment struct {
+	Path      string `json:"path" jsonschema:"required,description=Path of the file to comment on\\, relative to the repo root"`
+	Line      int    `json:"line" jsonschema:"required,description=Line number in the diff to anchor the comment to"`
+	Side      string `json:"side,omitempty" jsonschema:"description=Which side of the diff the line refers to\\, LEFT or RIGHT. Defaults to RIGHT"`
+	StartLine int    `json:"start_line,omitempty" jsonschema:"description=First line of a multi-line comment range. Omit for single-line comments"`
+	Body      string `json:"body" jsonschema:"required,description=Content of the comment"`
+}
+
+// PRReviewInlineRequest represents the parameters for submitting a PR review with inline comments.
+type PRReviewInlineRequest struct {
+	PRURL      string          `json:"pr_url" jsonschema:"required,description=URL of the pull request to review"`
+	ReviewBody string          `json:"review_body,omitempty" jsonschema:"description=Summary body of the review"`
+	Event      string          `json:"event,omitempty" jsonschema:"description=Review event: COMMENT\\, APPROVE\\, or REQUEST_CHANGES. Defaults to COMMENT"`
+	Comments   []InlineComment `json:"comments,omitempty" jsonschema:"description=Per-file\\, per-line comments to attach to the review"`
+}
+
 // GitHubPRFile represents a file in a pull request with its changes
 type GitHubPRFile struct {
 	Filename    string `json:"filename"`
@@ -56,6 +80,12 @@ type PRReviewRequest struct {
 	PRURL string `json:"pr_url" jsonschema:"required,description=URL of the pull request to review"`
 }
 
+// PRDiffRequest represents the parameters for fetching a pull request's parsed diff.
+type PRDiffRequest struct {
+	PRURL                          string `json:"pr_url" jsonschema:"required,description=URL of the pull request to review"`
+	IncludeFullContentForTruncated bool   `json:"include_full_content_for_truncated,omitempty" jsonschema:"description=Fetch full file contents at the PR head SHA for files whose patch was omitted by GitHub for being too large"`
+}
+
 // PRReview represents a review of a pull request
 type PRReview struct {
 	Body    string `json:"body"`
@@ -64,18 +94,151 @@ type PRReview struct {
 
 // GithubToolHandler handles requests related to GitHub actions.
 type GithubToolHandler struct {
-	client *github.Client
+	client   *github.Client
+	v4Client *githubv4.Client
+
+	// UseGraphQL selects the GraphQL-backed implementation of ListMyOpenPullRequestsAcrossRepos
+	// instead of the legacy REST (search + per-PR Get) implementation. Defaults to true.
+	UseGraphQL bool
+
+	// reviewStore caches fetched PR content and submitted/draft reviews across runs. May be
+	// nil, in which case GetPullRequestContents and SubmitPullRequestReview always hit the
+	// API and draft reviews are unsupported.
+	reviewStore store.ReviewStore
+}
+
+// Option configures a GithubToolHandler constructed via NewGithubToolHandler.
+type Option func(*options)
+
+type options struct {
+	maxRetries     int
+	maxWaitPerCall time.Duration
+	reviewStore    store.ReviewStore
+}
+
+// WithMaxRetries sets how many times an idempotent call is retried after hitting a GitHub
+// rate limit before giving up. Defaults to 3.
+func WithMaxRetries(maxRetries int) Option {
+	return func(o *options) {
+		o.maxRetries = maxRetries
+	}
+}
+
+// WithMaxWaitPerCall caps how long a single retry waits for a rate limit to reset, even if
+// the limit's reset time or Retry-After header asks for longer. Defaults to 2 minutes.
+func WithMaxWaitPerCall(maxWaitPerCall time.Duration) Option {
+	return func(o *options) {
+		o.maxWaitPerCall = maxWaitPerCall
+	}
+}
+
+// WithReviewStore configures a ReviewStore so GetPullRequestContents can skip re-fetching
+// PR content that hasn't changed, SubmitPullRequestReview can skip resubmitting a review
+// it already posted, and the save_draft_review/list_draft_reviews tools have somewhere to
+// persist drafts. If never set, these features are disabled and every call hits the API.
+func WithReviewStore(reviewStore store.ReviewStore) Option {
+	return func(o *options) {
+		o.reviewStore = reviewStore
+	}
 }
 
-// NewGithubToolHandler creates a new GithubToolHandler.
-func NewGithubToolHandler(client *github.Client) *GithubToolHandler {
+// NewGithubToolHandler creates a new GithubToolHandler. Both client and v4HTTPClient have
+// their underlying transport wrapped with the same rate-limit-aware retry/backoff,
+// configurable via Option, so the GraphQL path used by default (see UseGraphQL) is covered
+// the same as the REST fallback.
+func NewGithubToolHandler(client *github.Client, v4HTTPClient *http.Client, opts ...Option) *GithubToolHandler {
+	o := options{
+		maxRetries:     defaultMaxRetries,
+		maxWaitPerCall: defaultMaxWaitPerCall,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return &GithubToolHandler{
-		client: client,
+		client:      withRateLimitRetry(client, o.maxRetries, o.maxWaitPerCall),
+		v4Client:    githubv4.NewClient(withRateLimitRetryHTTPClient(v4HTTPClient, o.maxRetries, o.maxWaitPerCall)),
+		UseGraphQL:  true,
+		reviewStore: o.reviewStore,
 	}
 }
 
 // ListMyOpenPullRequestsAcrossRepos lists open PRs authored by the authenticated user.
 func (h *GithubToolHandler) ListMyOpenPullRequestsAcrossRepos(ctx context.Context) ([]GitHubPR, error) {
+	if h.UseGraphQL {
+		return h.listMyOpenPullRequestsAcrossReposGraphQL(ctx)
+	}
+	return h.listMyOpenPullRequestsAcrossReposREST(ctx)
+}
+
+// prSearchQuery mirrors `search(query: "is:pr is:open author:@me", type: ISSUE, first: 100)`,
+// selecting only the fields ListMyOpenPullRequestsAcrossRepos needs off each result.
+type prSearchQuery struct {
+	Search struct {
+		Nodes []struct {
+			PullRequest struct {
+				Number      int
+				Title       string
+				URL         string `graphql:"url"`
+				BaseRefName string
+				HeadRefName string
+				Repository  struct {
+					Owner struct {
+						Login string
+					}
+					Name string
+				}
+			} `graphql:"... on PullRequest"`
+		}
+		PageInfo struct {
+			HasNextPage bool
+			EndCursor   githubv4.String
+		}
+	} `graphql:"search(query: $query, type: ISSUE, first: 100, after: $cursor)"`
+}
+
+// listMyOpenPullRequestsAcrossReposGraphQL fetches every open PR authored by the
+// authenticated user in a single paginated GraphQL query, avoiding the N+1 REST
+// fan-out (one Search.Issues call plus one PullRequests.Get per result) that the
+// REST implementation requires.
+func (h *GithubToolHandler) listMyOpenPullRequestsAcrossReposGraphQL(ctx context.Context) ([]GitHubPR, error) {
+	variables := map[string]any{
+		"query":  githubv4.String("is:pr is:open author:@me"),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	var allMyOpenPRs []GitHubPR
+	for {
+		var query prSearchQuery
+		if err := h.v4Client.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to query open pull requests: %w", err)
+		}
+
+		for _, node := range query.Search.Nodes {
+			pr := node.PullRequest
+			allMyOpenPRs = append(allMyOpenPRs, GitHubPR{
+				Number:    pr.Number,
+				Title:     pr.Title,
+				URL:       pr.URL,
+				Base:      pr.BaseRefName,
+				Head:      pr.HeadRefName,
+				RepoOwner: pr.Repository.Owner.Login,
+				RepoName:  pr.Repository.Name,
+			})
+		}
+
+		if !query.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Search.PageInfo.EndCursor)
+	}
+	return allMyOpenPRs, nil
+}
+
+// listMyOpenPullRequestsAcrossReposREST is the legacy implementation, kept as a fallback
+// behind UseGraphQL. It issues a search followed by one PullRequests.Get per result, which
+// can exhaust the REST rate limit for users with many open PRs.
+func (h *GithubToolHandler) listMyOpenPullRequestsAcrossReposREST(ctx context.Context) ([]GitHubPR, error) {
 	user, _, err := h.client.Users.Get(ctx, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get authenticated user: %w", err)
@@ -89,36 +252,43 @@ func (h *GithubToolHandler) ListMyOpenPullRequestsAcrossRepos(ctx context.Contex
 	}
 
 	var allMyOpenPRs []GitHubPR
-	searchResults, _, err := h.client.Search.Issues(ctx, searchQuery, searchOpts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search for pull requests: %w", err)
-	}
-
-	for _, issue := range searchResults.Issues {
-		// extract owner and repo from the repository URL
-		prURLParts := strings.Split(issue.GetHTMLURL(), "/")
-		if len(prURLParts) < 5 {
-			continue
+	for {
+		searchResults, resp, err := h.client.Search.Issues(ctx, searchQuery, searchOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search for pull requests: %w", err)
 		}
-		owner := prURLParts[len(prURLParts)-4]
-		repoName := prURLParts[len(prURLParts)-3]
 
-		// get PR details to get base and head refs
-		pr, _, err := h.client.PullRequests.Get(ctx, owner, repoName, issue.GetNumber())
-		if err != nil {
-			log.Printf("failed to get PR details for %s/%s#%d: %v", owner, repoName, issue.GetNumber(), err)
-			continue
+		for _, issue := range searchResults.Issues {
+			// extract owner and repo from the repository URL
+			prURLParts := strings.Split(issue.GetHTMLURL(), "/")
+			if len(prURLParts) < 5 {
+				continue
+			}
+			owner := prURLParts[len(prURLParts)-4]
+			repoName := prURLParts[len(prURLParts)-3]
+
+			// get PR details to get base and head refs
+			pr, _, err := h.client.PullRequests.Get(ctx, owner, repoName, issue.GetNumber())
+			if err != nil {
+				log.Printf("failed to get PR details for %s/%s#%d: %v", owner, repoName, issue.GetNumber(), err)
+				continue
+			}
+
+			allMyOpenPRs = append(allMyOpenPRs, GitHubPR{
+				Number:    issue.GetNumber(),
+				Title:     issue.GetTitle(),
+				URL:       issue.GetHTMLURL(),
+				Base:      pr.GetBase().GetRef(),
+				Head:      pr.GetHead().GetRef(),
+				RepoOwner: owner,
+				RepoName:  repoName,
+			})
 		}
 
-		allMyOpenPRs = append(allMyOpenPRs, GitHubPR{
-			Number:    issue.GetNumber(),
-			Title:     issue.GetTitle(),
-			URL:       issue.GetHTMLURL(),
-			Base:      pr.GetBase().GetRef(),
-			Head:      pr.GetHead().GetRef(),
-			RepoOwner: owner,
-			RepoName:  repoName,
-		})
+		if resp.NextPage == 0 {
+			break
+		}
+		searchOpts.Page = resp.NextPage
 	}
 	return allMyOpenPRs, nil
 }
@@ -137,24 +307,45 @@ func (h *GithubToolHandler) GetPullRequestContents(ctx context.Context, prURLStr
 		return nil, fmt.Errorf("failed to get PR details: %w", err)
 	}
 
-	files, _, err := h.client.PullRequests.ListFiles(ctx, owner, repo, prNumber, &github.ListOptions{PerPage: 100})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list files in PR: %w", err)
+	cacheKey := prContentCacheKey(owner, repo, prNumber, pr.GetHead().GetSHA())
+	if h.reviewStore != nil {
+		if cached, ok, err := h.reviewStore.GetPRContent(ctx, cacheKey); err != nil {
+			log.Printf("failed to read cached PR content for %s: %v", cacheKey, err)
+		} else if ok {
+			var content GitHubPRContent
+			if err := json.Unmarshal(cached, &content); err != nil {
+				log.Printf("failed to decode cached PR content for %s: %v", cacheKey, err)
+			} else {
+				return &content, nil
+			}
+		}
 	}
 
 	prFiles := []GitHubPRFile{}
-	for _, file := range files {
-		prFile := GitHubPRFile{
-			Filename:    file.GetFilename(),
-			Status:      file.GetStatus(),
-			Additions:   file.GetAdditions(),
-			Deletions:   file.GetDeletions(),
-			Changes:     file.GetChanges(),
-			Patch:       file.GetPatch(),
-			BlobURL:     file.GetBlobURL(),
-			ContentsURL: file.GetContentsURL(),
+	listOpts := &github.ListOptions{PerPage: 100}
+	for {
+		files, resp, err := h.client.PullRequests.ListFiles(ctx, owner, repo, prNumber, listOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files in PR: %w", err)
 		}
-		prFiles = append(prFiles, prFile)
+
+		for _, file := range files {
+			prFiles = append(prFiles, GitHubPRFile{
+				Filename:    file.GetFilename(),
+				Status:      file.GetStatus(),
+				Additions:   file.GetAdditions(),
+				Deletions:   file.GetDeletions(),
+				Changes:     file.GetChanges(),
+				Patch:       file.GetPatch(),
+				BlobURL:     file.GetBlobURL(),
+				ContentsURL: file.GetContentsURL(),
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
 	}
 
 	prContent := &GitHubPRContent{
@@ -170,31 +361,247 @@ func (h *GithubToolHandler) GetPullRequestContents(ctx context.Context, prURLStr
 		Files:       prFiles,
 		Description: pr.GetBody(),
 	}
+
+	if h.reviewStore != nil {
+		if encoded, err := json.Marshal(prContent); err != nil {
+			log.Printf("failed to encode PR content for %s: %v", cacheKey, err)
+		} else if err := h.reviewStore.PutPRContent(ctx, cacheKey, encoded); err != nil {
+			log.Printf("failed to cache PR content for %s: %v", cacheKey, err)
+		}
+	}
 	return prContent, nil
 }
 
-// SubmitPullRequestReview submits a review on a pull request
-func (h *GithubToolHandler) SubmitPullRequestReview(ctx context.Context, prURLStr string, reviewBody string) (*PRReview, error) {
+// prContentCacheKey builds the cache key under which a PR's content is stored, scoped to
+// its head SHA so a new push naturally invalidates the cached entry.
+func prContentCacheKey(owner, repo string, prNumber int, headSHA string) string {
+	return fmt.Sprintf("%s/%s/%d@%s", owner, repo, prNumber, headSHA)
+}
+
+// GetPullRequestDiff fetches a pull request's unified diff and parses it into per-file
+// hunks with absolute old/new line numbers, which the raw Patch string on GitHubPRFile
+// does not provide and which GitHub omits outright for large files. If
+// includeFullContentForTruncated is true, files with no parsed hunks have their full
+// content at the PR's head SHA fetched and attached.
+func (h *GithubToolHandler) GetPullRequestDiff(ctx context.Context, prURLStr string, includeFullContentForTruncated bool) (*PRDiff, error) {
+	owner, repo, prNumber, err := parsePullRequestURL(prURLStr)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, _, err := h.client.PullRequests.Get(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR details: %w", err)
+	}
+
+	raw, _, err := h.client.PullRequests.GetRaw(ctx, owner, repo, prNumber, github.RawOptions{Type: github.Diff})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR diff: %w", err)
+	}
+
+	files, err := parseUnifiedDiff(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PR diff: %w", err)
+	}
+
+	if includeFullContentForTruncated {
+		for i := range files {
+			if len(files[i].Hunks) > 0 {
+				continue
+			}
+			content, err := h.getFileContentAtRef(ctx, owner, repo, files[i].Path, pr.GetHead().GetSHA())
+			if err != nil {
+				log.Printf("failed to get full content for truncated file %s: %v", files[i].Path, err)
+				continue
+			}
+			files[i].FullContent = content
+		}
+	}
+
+	return &PRDiff{
+		PR: GitHubPR{
+			Number:    pr.GetNumber(),
+			Title:     pr.GetTitle(),
+			URL:       pr.GetHTMLURL(),
+			Base:      pr.GetBase().GetRef(),
+			Head:      pr.GetHead().GetRef(),
+			RepoOwner: owner,
+			RepoName:  repo,
+		},
+		Files: files,
+	}, nil
+}
+
+// getFileContentAtRef fetches the decoded content of a single file at the given ref.
+func (h *GithubToolHandler) getFileContentAtRef(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	fileContent, _, _, err := h.client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return "", fmt.Errorf("failed to get contents of %s at %s: %w", path, ref, err)
+	}
+	if fileContent == nil {
+		return "", fmt.Errorf("%s at %s is a directory, not a file", path, ref)
+	}
+	return fileContent.GetContent()
+}
+
+// SubmitPullRequestReview submits a review on a pull request. event must be one of
+// COMMENT, APPROVE, or REQUEST_CHANGES; if empty it defaults to COMMENT. comments, if
+// non-empty, are submitted as per-file, per-line draft review comments alongside reviewBody.
+func (h *GithubToolHandler) SubmitPullRequestReview(ctx context.Context, prURLStr string, reviewBody string, event string, comments []InlineComment) (*PRReview, error) {
 	owner, repo, prNumber, err := parsePullRequestURL(prURLStr)
 	if err != nil {
 		return nil, err
 	}
 
+	if event == "" {
+		event = "COMMENT"
+	}
+
+	submissionKey := reviewSubmissionKey(owner, repo, prNumber, reviewBody, event, comments)
+	if h.reviewStore != nil {
+		if alreadySubmitted, err := h.reviewStore.WasReviewSubmitted(ctx, submissionKey); err != nil {
+			log.Printf("failed to check submitted review %s: %v", submissionKey, err)
+		} else if alreadySubmitted {
+			return &PRReview{Body: reviewBody}, nil
+		}
+	}
+
 	reviewRequest := &github.PullRequestReviewRequest{
-		Body:  &reviewBody,
-		Event: github.String("COMMENT"),
+		Body:     &reviewBody,
+		Event:    github.String(event),
+		Comments: toDraftReviewComments(comments),
 	}
 
 	review, _, err := h.client.PullRequests.CreateReview(ctx, owner, repo, prNumber, reviewRequest)
 	if err != nil {
 		return nil, fmt.Errorf("could not submit PR review: %w", err)
 	}
+
+	if h.reviewStore != nil {
+		if err := h.reviewStore.RecordReviewSubmitted(ctx, submissionKey); err != nil {
+			log.Printf("failed to record submitted review %s: %v", submissionKey, err)
+		}
+	}
+
 	return &PRReview{
 		Body:    review.GetBody(),
 		HTMLURL: review.GetHTMLURL(),
 	}, nil
 }
 
+// reviewSubmissionKey identifies a specific review's content on a specific PR, so re-running
+// SubmitPullRequestReview with the same arguments is idempotent.
+func reviewSubmissionKey(owner, repo string, prNumber int, reviewBody, event string, comments []InlineComment) string {
+	encoded, _ := json.Marshal(struct {
+		Body     string          `json:"body"`
+		Event    string          `json:"event"`
+		Comments []InlineComment `json:"comments,omitempty"`
+	}{reviewBody, event, comments})
+	return fmt.Sprintf("%s/%s/%d:%x", owner, repo, prNumber, sha256.Sum256(encoded))
+}
+
+// toDraftReviewComments maps inline comments to the go-github draft review comment shape.
+func toDraftReviewComments(comments []InlineComment) []*github.DraftReviewComment {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	draftComments := make([]*github.DraftReviewComment, 0, len(comments))
+	for _, c := range comments {
+		side := c.Side
+		if side == "" {
+			side = "RIGHT"
+		}
+
+		draftComment := &github.DraftReviewComment{
+			Path: github.String(c.Path),
+			Line: github.Int(c.Line),
+			Side: github.String(side),
+			Body: github.String(c.Body),
+		}
+		if c.StartLine > 0 {
+			draftComment.StartLine = github.Int(c.StartLine)
+			draftComment.StartSide = github.String(side)
+		}
+		draftComments = append(draftComments, draftComment)
+	}
+	return draftComments
+}
+
+// SaveDraftReviewRequest represents the parameters for saving a draft review.
+type SaveDraftReviewRequest struct {
+	PRURL string `json:"pr_url" jsonschema:"required,description=URL of the pull request the draft review is for"`
+	Body  string `json:"body" jsonschema:"required,description=Content of the draft review"`
+}
+
+// ListDraftReviewsRequest represents the parameters for listing draft reviews.
+type ListDraftReviewsRequest struct {
+	PRURL string `json:"pr_url" jsonschema:"required,description=URL of the pull request to list draft reviews for"`
+}
+
+// DraftReview is a review body the LLM has produced for a PR but not yet submitted.
+type DraftReview struct {
+	ID        string    `json:"id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SaveDraftReview persists a draft review body for prURLStr so a multi-turn agent can pick
+// it back up and iterate on it across sessions. Requires a ReviewStore (see WithReviewStore).
+func (h *GithubToolHandler) SaveDraftReview(ctx context.Context, prURLStr string, body string) (*DraftReview, error) {
+	if h.reviewStore == nil {
+		return nil, fmt.Errorf("no review store configured: pass gh.WithReviewStore to NewGithubToolHandler")
+	}
+
+	owner, repo, prNumber, err := parsePullRequestURL(prURLStr)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := store.DraftReview{
+		ID:        draftReviewID(owner, repo, prNumber, body),
+		PRKey:     fmt.Sprintf("%s/%s/%d", owner, repo, prNumber),
+		Body:      body,
+		CreatedAt: time.Now(),
+	}
+	if err := h.reviewStore.SaveDraftReview(ctx, draft); err != nil {
+		return nil, fmt.Errorf("could not save draft review: %w", err)
+	}
+
+	return &DraftReview{ID: draft.ID, Body: draft.Body, CreatedAt: draft.CreatedAt}, nil
+}
+
+// ListDraftReviews returns every draft review saved for prURLStr, oldest first. Requires a
+// ReviewStore (see WithReviewStore).
+func (h *GithubToolHandler) ListDraftReviews(ctx context.Context, prURLStr string) ([]DraftReview, error) {
+	if h.reviewStore == nil {
+		return nil, fmt.Errorf("no review store configured: pass gh.WithReviewStore to NewGithubToolHandler")
+	}
+
+	owner, repo, prNumber, err := parsePullRequestURL(prURLStr)
+	if err != nil {
+		return nil, err
+	}
+
+	prKey := fmt.Sprintf("%s/%s/%d", owner, repo, prNumber)
+	drafts, err := h.reviewStore.ListDraftReviews(ctx, prKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not list draft reviews: %w", err)
+	}
+
+	result := make([]DraftReview, 0, len(drafts))
+	for _, d := range drafts {
+		result = append(result, DraftReview{ID: d.ID, Body: d.Body, CreatedAt: d.CreatedAt})
+	}
+	return result, nil
+}
+
+// draftReviewID derives a stable ID for a draft review from its PR and content, so saving
+// the same draft body twice overwrites rather than duplicates it.
+func draftReviewID(owner, repo string, prNumber int, body string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%d:%s", owner, repo, prNumber, body))))
+}
+
 func parsePullRequestURL(prURLStr string) (owner, repo string, prNumber int, err error) {
 	parts := strings.Split(prURLStr, "/")
 	pullIndex := -1
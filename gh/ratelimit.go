@@ -0,0 +1,160 @@
+package gh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v52/github"
+)
+
+const (
+	defaultMaxRetries     = 3
+	defaultMaxWaitPerCall = 2 * time.Minute
+)
+
+// rateLimitTransport wraps an http.RoundTripper, retrying idempotent requests that hit a
+// primary or secondary GitHub rate limit: plain GETs, and POSTs carrying a GraphQL query
+// (as opposed to a mutation), since those are also read-only. It sleeps until the limit
+// resets (or until Retry-After elapses), bounded by maxWaitPerCall, and gives up after
+// maxRetries retries. Sleeps respect the request's context so a canceled ctx aborts the
+// wait immediately.
+type rateLimitTransport struct {
+	base           http.RoundTripper
+	maxRetries     int
+	maxWaitPerCall time.Duration
+}
+
+// newRateLimitTransport wraps base with rate-limit-aware retry behavior. base defaults to
+// http.DefaultTransport if nil.
+func newRateLimitTransport(base http.RoundTripper, maxRetries int, maxWaitPerCall time.Duration) *rateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitTransport{
+		base:           base,
+		maxRetries:     maxRetries,
+		maxWaitPerCall: maxWaitPerCall,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var bodyBytes []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		bodyBytes = b
+	}
+	idempotent := req.Method == http.MethodGet || (req.Method == http.MethodPost && isRetryableGraphQLBody(bodyBytes))
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		if bodyBytes != nil {
+			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+			req.ContentLength = int64(len(bodyBytes))
+		}
+
+		resp, err = t.base.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+
+		wait, shouldRetry := t.waitBeforeRetry(resp)
+		if !shouldRetry || !idempotent || attempt >= t.maxRetries {
+			return resp, nil
+		}
+
+		if wait > t.maxWaitPerCall {
+			wait = t.maxWaitPerCall
+		}
+
+		resp.Body.Close()
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isRetryableGraphQLBody reports whether a POST body is a GraphQL query (read-only, safe
+// to retry) rather than a mutation. shurcooL/graphql's Client.Query always issues POST, so
+// gating retries on method alone would never retry the GraphQL path.
+func isRetryableGraphQLBody(body []byte) bool {
+	var payload struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+	if payload.Query == "" {
+		return false
+	}
+	return !strings.HasPrefix(strings.ToLower(strings.TrimSpace(payload.Query)), "mutation")
+}
+
+// waitBeforeRetry inspects resp for a primary (X-RateLimit-Remaining: 0) or secondary
+// (Retry-After) rate limit and reports how long to wait before retrying, if at all.
+func (t *rateLimitTransport) waitBeforeRetry(resp *http.Response) (wait time.Duration, shouldRetry bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining == "0" {
+		if reset := resp.Header.Get("X-RateLimit-Reset"); reset != "" {
+			if epochSeconds, err := strconv.ParseInt(reset, 10, 64); err == nil {
+				wait := time.Until(time.Unix(epochSeconds, 0))
+				if wait < 0 {
+					wait = 0
+				}
+				return wait, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// sleepContext sleeps for d, returning ctx.Err() early if ctx is canceled first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// withRateLimitRetry wraps client's underlying http.Client transport with rate-limit-aware
+// retry behavior and returns a new *github.Client using it.
+func withRateLimitRetry(client *github.Client, maxRetries int, maxWaitPerCall time.Duration) *github.Client {
+	httpClient := client.Client()
+	httpClient.Transport = newRateLimitTransport(httpClient.Transport, maxRetries, maxWaitPerCall)
+	return github.NewClient(httpClient)
+}
+
+// withRateLimitRetryHTTPClient wraps httpClient's transport with the same rate-limit-aware
+// retry behavior as withRateLimitRetry, for callers (like the GraphQL client) that aren't
+// built from a *github.Client.
+func withRateLimitRetryHTTPClient(httpClient *http.Client, maxRetries int, maxWaitPerCall time.Duration) *http.Client {
+	wrapped := *httpClient
+	wrapped.Transport = newRateLimitTransport(httpClient.Transport, maxRetries, maxWaitPerCall)
+	return &wrapped
+}
@@ -0,0 +1,241 @@
+// Package gitea implements forge.Client against a Gitea (or Gogs) instance.
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/alesr/gh-self-reviewer/forge"
+	"github.com/alesr/gh-self-reviewer/gh"
+)
+
+// Client implements forge.Client against a Gitea/Gogs instance.
+type Client struct {
+	client *gitea.Client
+}
+
+var _ forge.Client = (*Client)(nil)
+
+// New creates a new Client for the Gitea instance at baseURL, authenticating with token.
+func New(baseURL, token string) (*Client, error) {
+	client, err := gitea.NewClient(baseURL, gitea.SetToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gitea client: %w", err)
+	}
+	return &Client{client: client}, nil
+}
+
+// ListMyOpenPRs implements forge.Client.
+func (c *Client) ListMyOpenPRs(ctx context.Context) ([]forge.PR, error) {
+	user, _, err := c.client.GetMyUserInfo()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+
+	const pageSize = 50
+
+	var prs []forge.PR
+	for page := 1; ; page++ {
+		issues, _, err := c.client.ListIssues(gitea.ListIssueOption{
+			Type:        gitea.IssueTypePull,
+			State:       gitea.StateOpen,
+			CreatedBy:   user.UserName,
+			ListOptions: gitea.ListOptions{Page: page, PageSize: pageSize},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list open pull requests: %w", err)
+		}
+
+		for _, issue := range issues {
+			owner, repo, err := ownerRepoFromHTMLURL(issue.HTMLURL)
+			if err != nil {
+				continue
+			}
+
+			pr, _, err := c.client.GetPullRequest(owner, repo, issue.Index)
+			if err != nil {
+				continue
+			}
+
+			prs = append(prs, forge.PR{
+				Number:    int(issue.Index),
+				Title:     issue.Title,
+				URL:       issue.HTMLURL,
+				Base:      pr.Base.Ref,
+				Head:      pr.Head.Ref,
+				RepoOwner: owner,
+				RepoName:  repo,
+			})
+		}
+
+		if len(issues) < pageSize {
+			break
+		}
+	}
+	return prs, nil
+}
+
+// GetPRContents implements forge.Client.
+func (c *Client) GetPRContents(ctx context.Context, url string) (*forge.PRContent, error) {
+	owner, repo, number, err := c.ParsePRURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, _, err := c.client.GetPullRequest(owner, repo, int64(number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR details: %w", err)
+	}
+
+	diffFiles, err := diffFilesForPR(c.client, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return &forge.PRContent{
+		PR: forge.PR{
+			Number:    number,
+			Title:     pr.Title,
+			URL:       pr.HTMLURL,
+			Base:      pr.Base.Ref,
+			Head:      pr.Head.Ref,
+			RepoOwner: owner,
+			RepoName:  repo,
+		},
+		Files:       diffFiles,
+		Description: pr.Body,
+	}, nil
+}
+
+// SubmitReview implements forge.Client.
+func (c *Client) SubmitReview(ctx context.Context, url string, body string, opts *forge.ReviewOptions) (*forge.Review, error) {
+	owner, repo, number, err := c.ParsePRURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	event := gitea.ReviewStateComment
+	var comments []gitea.CreatePullReviewComment
+	if opts != nil {
+		if opts.Event != "" {
+			event = reviewStateForEvent(opts.Event)
+		}
+		for _, comment := range opts.Comments {
+			comments = append(comments, gitea.CreatePullReviewComment{
+				Path:       comment.Path,
+				Body:       comment.Body,
+				NewLineNum: int64(comment.Line),
+			})
+		}
+	}
+
+	review, _, err := c.client.CreatePullReview(owner, repo, int64(number), gitea.CreatePullReviewOptions{
+		State:    event,
+		Body:     body,
+		Comments: comments,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not submit PR review: %w", err)
+	}
+	return &forge.Review{
+		Body:    review.Body,
+		HTMLURL: review.HTMLURL,
+	}, nil
+}
+
+// reviewStateForEvent maps the GitHub-style review event vocabulary used throughout this
+// codebase (see forge.ReviewOptions: COMMENT, APPROVE, REQUEST_CHANGES) to
+// Gitea's own ReviewStateType constants, which use different spelling and casing. Anything
+// unrecognized falls back to a plain comment, the same default SubmitReview uses when no
+// event is given at all.
+func reviewStateForEvent(event string) gitea.ReviewStateType {
+	switch strings.ToUpper(event) {
+	case "APPROVE":
+		return gitea.ReviewStateApproved
+	case "REQUEST_CHANGES":
+		return gitea.ReviewStateRequestChanges
+	default:
+		return gitea.ReviewStateComment
+	}
+}
+
+// ParsePRURL implements forge.Client. Gitea PR URLs look like
+// https://gitea.example.com/{owner}/{repo}/pulls/{number}.
+func (c *Client) ParsePRURL(url string) (owner, repo string, number int, err error) {
+	parts := strings.Split(url, "/")
+	pullsIndex := -1
+	for i, part := range parts {
+		if part == "pulls" {
+			pullsIndex = i
+			break
+		}
+	}
+	if pullsIndex < 2 || pullsIndex+1 >= len(parts) {
+		return "", "", 0, fmt.Errorf("invalid pull request URL: %s", url)
+	}
+
+	owner = parts[pullsIndex-2]
+	repo = parts[pullsIndex-1]
+	number, err = strconv.Atoi(parts[pullsIndex+1])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid pull request number in URL: %s", url)
+	}
+	return owner, repo, number, nil
+}
+
+func ownerRepoFromHTMLURL(htmlURL string) (owner, repo string, err error) {
+	parts := strings.Split(htmlURL, "/")
+	if len(parts) < 5 {
+		return "", "", fmt.Errorf("invalid issue URL: %s", htmlURL)
+	}
+	return parts[len(parts)-4], parts[len(parts)-3], nil
+}
+
+// diffFilesForPR fetches the PR's raw unified diff via the Gitea SDK and reuses gh's diff
+// parser to turn it into per-file patches, the same mechanism GetPullRequestDiff uses for
+// the GitHub backend.
+func diffFilesForPR(client *gitea.Client, owner, repo string, number int) ([]forge.PRFile, error) {
+	raw, _, err := client.GetPullRequestDiff(owner, repo, int64(number), gitea.PullRequestDiffOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request diff: %w", err)
+	}
+
+	fileDiffs, err := gh.ParseUnifiedDiff(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pull request diff: %w", err)
+	}
+
+	files := make([]forge.PRFile, 0, len(fileDiffs))
+	for _, fd := range fileDiffs {
+		var patch strings.Builder
+		additions, deletions := 0, 0
+		for _, h := range fd.Hunks {
+			fmt.Fprintf(&patch, "@@ -%d,%d +%d,%d @@\n", h.OldStart, h.OldLines, h.NewStart, h.NewLines)
+			for _, l := range h.Lines {
+				switch l.Kind {
+				case "addition":
+					additions++
+					patch.WriteString("+" + l.Content + "\n")
+				case "deletion":
+					deletions++
+					patch.WriteString("-" + l.Content + "\n")
+				default:
+					patch.WriteString(" " + l.Content + "\n")
+				}
+			}
+		}
+
+		files = append(files, forge.PRFile{
+			Filename:  fd.Path,
+			Additions: additions,
+			Deletions: deletions,
+			Changes:   additions + deletions,
+			Patch:     patch.String(),
+		})
+	}
+	return files, nil
+}
@@ -0,0 +1,243 @@
+// Package gitlab implements forge.Client against a GitLab instance, treating merge
+// requests as the forge.PR equivalent.
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/alesr/gh-self-reviewer/forge"
+)
+
+// Client implements forge.Client against a GitLab instance.
+type Client struct {
+	client *gitlab.Client
+}
+
+var _ forge.Client = (*Client)(nil)
+
+// New creates a new Client for the GitLab instance at baseURL, authenticating with token.
+func New(baseURL, token string) (*Client, error) {
+	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(baseURL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab client: %w", err)
+	}
+	return &Client{client: client}, nil
+}
+
+// ListMyOpenPRs implements forge.Client, listing open merge requests authored by the
+// authenticated user across all projects the token can see.
+func (c *Client) ListMyOpenPRs(ctx context.Context) ([]forge.PR, error) {
+	user, _, err := c.client.Users.CurrentUser()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+
+	opened := "opened"
+	listOpts := &gitlab.ListMergeRequestsOptions{
+		AuthorID: gitlab.Ptr(user.ID),
+		State:    &opened,
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	var prs []forge.PR
+	for {
+		mrs, resp, err := c.client.MergeRequests.ListMergeRequests(listOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list open merge requests: %w", err)
+		}
+
+		for _, mr := range mrs {
+			owner, repo := ownerRepoFromPathWithNamespace(mr.References.Full)
+			prs = append(prs, forge.PR{
+				Number:    mr.IID,
+				Title:     mr.Title,
+				URL:       mr.WebURL,
+				Base:      mr.TargetBranch,
+				Head:      mr.SourceBranch,
+				RepoOwner: owner,
+				RepoName:  repo,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+	return prs, nil
+}
+
+// GetPRContents implements forge.Client.
+func (c *Client) GetPRContents(ctx context.Context, url string) (*forge.PRContent, error) {
+	owner, repo, number, err := c.ParsePRURL(url)
+	if err != nil {
+		return nil, err
+	}
+	projectID := owner + "/" + repo
+
+	mr, _, err := c.client.MergeRequests.GetMergeRequest(projectID, number, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merge request details: %w", err)
+	}
+
+	diffOpts := &gitlab.ListMergeRequestDiffsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	var files []forge.PRFile
+	for {
+		changes, resp, err := c.client.MergeRequests.ListMergeRequestDiffs(projectID, number, diffOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list merge request diffs: %w", err)
+		}
+
+		for _, change := range changes {
+			status := "modified"
+			switch {
+			case change.NewFile:
+				status = "added"
+			case change.DeletedFile:
+				status = "removed"
+			case change.RenamedFile:
+				status = "renamed"
+			}
+
+			files = append(files, forge.PRFile{
+				Filename: change.NewPath,
+				Status:   status,
+				Patch:    change.Diff,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		diffOpts.Page = resp.NextPage
+	}
+
+	return &forge.PRContent{
+		PR: forge.PR{
+			Number:    number,
+			Title:     mr.Title,
+			URL:       mr.WebURL,
+			Base:      mr.TargetBranch,
+			Head:      mr.SourceBranch,
+			RepoOwner: owner,
+			RepoName:  repo,
+		},
+		Files:       files,
+		Description: mr.Description,
+	}, nil
+}
+
+// SubmitReview implements forge.Client. GitLab has no "pending review" concept like
+// GitHub's Review API, so a summary note and any inline comments are each posted as
+// individual discussion notes; opts.Event is ignored (GitLab approval is a separate call).
+// Each inline comment is anchored to its Line in the new version of the file; Side and
+// StartLine are not used.
+func (c *Client) SubmitReview(ctx context.Context, url string, body string, opts *forge.ReviewOptions) (*forge.Review, error) {
+	owner, repo, number, err := c.ParsePRURL(url)
+	if err != nil {
+		return nil, err
+	}
+	projectID := owner + "/" + repo
+
+	var note *gitlab.Note
+	if body != "" {
+		note, _, err = c.client.Notes.CreateMergeRequestNote(projectID, number, &gitlab.CreateMergeRequestNoteOptions{
+			Body: &body,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not submit merge request review note: %w", err)
+		}
+	}
+
+	if opts != nil && len(opts.Comments) > 0 {
+		// A discussion's Position must be anchored to the MR's current diff refs, or
+		// GitLab rejects it with "Position is invalid"; these aren't knowable from the
+		// URL alone, so the MR is re-fetched here rather than threaded through from
+		// GetPRContents.
+		mr, _, err := c.client.MergeRequests.GetMergeRequest(projectID, number, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get merge request details: %w", err)
+		}
+		if mr.DiffRefs.BaseSha == "" || mr.DiffRefs.HeadSha == "" || mr.DiffRefs.StartSha == "" {
+			return nil, fmt.Errorf("merge request %s has no diff refs to anchor inline comments to", projectID)
+		}
+
+		for _, comment := range opts.Comments {
+			position := &gitlab.PositionOptions{
+				BaseSHA:      &mr.DiffRefs.BaseSha,
+				StartSHA:     &mr.DiffRefs.StartSha,
+				HeadSHA:      &mr.DiffRefs.HeadSha,
+				PositionType: gitlab.Ptr("text"),
+				NewPath:      &comment.Path,
+				NewLine:      gitlab.Ptr(comment.Line),
+			}
+			if _, _, err := c.client.Discussions.CreateMergeRequestDiscussion(projectID, number, &gitlab.CreateMergeRequestDiscussionOptions{
+				Body:     &comment.Body,
+				Position: position,
+			}); err != nil {
+				return nil, fmt.Errorf("could not submit inline comment on %s:%d: %w", comment.Path, comment.Line, err)
+			}
+		}
+	}
+
+	review := &forge.Review{Body: body}
+	if note != nil {
+		review.HTMLURL = fmt.Sprintf("%s#note_%d", url, note.ID)
+	}
+	return review, nil
+}
+
+// ParsePRURL implements forge.Client. GitLab merge request URLs look like
+// https://gitlab.example.com/{namespace path}/-/merge_requests/{number}, where the
+// namespace path is the project's owner and may itself contain any number of
+// groups/subgroups (e.g. "group/subgroup/repo") before the project name.
+func (c *Client) ParsePRURL(url string) (owner, repo string, number int, err error) {
+	parts := strings.Split(url, "/")
+	mrIndex := -1
+	for i, part := range parts {
+		if part == "merge_requests" {
+			mrIndex = i
+			break
+		}
+	}
+	// parts[mrIndex-1] is the "-" GitLab always inserts before "merge_requests"; everything
+	// between the host (index 2) and that separator is the project's full namespace path.
+	if mrIndex < 6 || mrIndex+1 >= len(parts) || parts[mrIndex-1] != "-" {
+		return "", "", 0, fmt.Errorf("invalid merge request URL: %s", url)
+	}
+
+	namespacePath := parts[3 : mrIndex-1]
+	owner = strings.Join(namespacePath[:len(namespacePath)-1], "/")
+	repo = namespacePath[len(namespacePath)-1]
+
+	number, err = strconv.Atoi(parts[mrIndex+1])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid merge request number in URL: %s", url)
+	}
+	return owner, repo, number, nil
+}
+
+func ownerRepoFromPathWithNamespace(fullReference string) (owner, repo string) {
+	// fullReference looks like "owner/repo!123"; strip the "!123" suffix and split on "/".
+	path := fullReference
+	if idx := strings.Index(path, "!"); idx != -1 {
+		path = path[:idx]
+	}
+	idx := strings.LastIndex(path, "/")
+	if idx == -1 {
+		return "", path
+	}
+	return path[:idx], path[idx+1:]
+}
@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/alesr/gh-self-reviewer/forge"
 	"github.com/alesr/gh-self-reviewer/gh"
+	"github.com/alesr/gh-self-reviewer/gitea"
+	"github.com/alesr/gh-self-reviewer/gitlab"
+	"github.com/alesr/gh-self-reviewer/store"
 	"github.com/google/go-github/v52/github"
 	mcp "github.com/metoro-io/mcp-golang"
 	"github.com/metoro-io/mcp-golang/transport/stdio"
@@ -38,20 +44,22 @@ func main() {
 }
 
 func run(ctx context.Context) error {
-	token := os.Getenv("GITHUB_TOKEN_MCP_APP_REVIEW")
-	if token == "" {
-		return fmt.Errorf("GITHUB_TOKEN_MCP_APP_REVIEW environment variable is not set")
-	}
-
-	githubClient, err := makeGitHubClient(ctx)
+	forgeClient, reviewStoreCloser, err := makeForgeClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+		return fmt.Errorf("failed to initialize forge client: %w", err)
+	}
+	if reviewStoreCloser != nil {
+		defer func() {
+			if err := reviewStoreCloser.Close(); err != nil {
+				log.Printf("failed to close review store: %v", err)
+			}
+		}()
 	}
 
 	server := mcp.NewServer(stdio.NewStdioServerTransport())
 	log.Println("MCP server created")
 
-	if err := registerTools(ctx, server, gh.NewGithubToolHandler(githubClient)); err != nil {
+	if err := registerTools(ctx, server, forgeClient); err != nil {
 		return fmt.Errorf("could not register tools: %w", err)
 	}
 
@@ -70,6 +78,85 @@ func run(ctx context.Context) error {
 	return nil
 }
 
+// makeForgeClient picks the forge.Client implementation based on the FORGE environment
+// variable (github, gitea, or gitlab; defaults to github). Gitea and GitLab additionally
+// require FORGE_BASE_URL and FORGE_TOKEN; GitHub keeps using GITHUB_TOKEN_MCP_APP_REVIEW.
+// The returned io.Closer is non-nil only when a REVIEW_STORE_PATH-backed store was opened,
+// and must be closed by the caller on shutdown to release it.
+func makeForgeClient(ctx context.Context) (forge.Client, io.Closer, error) {
+	switch backend := os.Getenv("FORGE"); backend {
+	case "", "github":
+		return makeGithubForgeClient(ctx)
+	case "gitea":
+		client, err := makeGiteaForgeClient()
+		return client, nil, err
+	case "gitlab":
+		client, err := makeGitlabForgeClient()
+		return client, nil, err
+	default:
+		return nil, nil, fmt.Errorf("unknown FORGE backend %q: want github, gitea, or gitlab", backend)
+	}
+}
+
+func makeGithubForgeClient(ctx context.Context) (forge.Client, io.Closer, error) {
+	token := os.Getenv("GITHUB_TOKEN_MCP_APP_REVIEW")
+	if token == "" {
+		return nil, nil, fmt.Errorf("GITHUB_TOKEN_MCP_APP_REVIEW environment variable is not set")
+	}
+
+	githubClient, err := makeGitHubClient(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	githubV4HTTPClient, err := makeGitHubV4HTTPClient(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize GitHub GraphQL HTTP client: %w", err)
+	}
+
+	var handlerOpts []gh.Option
+	var reviewStoreCloser io.Closer
+	if storePath := os.Getenv("REVIEW_STORE_PATH"); storePath != "" {
+		reviewStore, err := store.NewBoltStore(storePath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open review store: %w", err)
+		}
+		handlerOpts = append(handlerOpts, gh.WithReviewStore(reviewStore))
+		reviewStoreCloser = reviewStore
+	}
+
+	return gh.NewGithubToolHandler(githubClient, githubV4HTTPClient, handlerOpts...), reviewStoreCloser, nil
+}
+
+func makeGiteaForgeClient() (forge.Client, error) {
+	baseURL, token, err := forgeBaseURLAndToken()
+	if err != nil {
+		return nil, err
+	}
+	return gitea.New(baseURL, token)
+}
+
+func makeGitlabForgeClient() (forge.Client, error) {
+	baseURL, token, err := forgeBaseURLAndToken()
+	if err != nil {
+		return nil, err
+	}
+	return gitlab.New(baseURL, token)
+}
+
+func forgeBaseURLAndToken() (baseURL, token string, err error) {
+	baseURL = os.Getenv("FORGE_BASE_URL")
+	if baseURL == "" {
+		return "", "", fmt.Errorf("FORGE_BASE_URL environment variable is not set")
+	}
+
+	token = os.Getenv("FORGE_TOKEN")
+	if token == "" {
+		return "", "", fmt.Errorf("FORGE_TOKEN environment variable is not set")
+	}
+	return baseURL, token, nil
+}
+
 func makeGitHubClient(ctx context.Context) (*github.Client, error) {
 	token := os.Getenv("GITHUB_TOKEN_MCP_APP_REVIEW")
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
@@ -77,12 +164,21 @@ func makeGitHubClient(ctx context.Context) (*github.Client, error) {
 	return github.NewClient(tc), nil
 }
 
-func registerTools(ctx context.Context, server *mcp.Server, githubToolHandler *gh.GithubToolHandler) error {
+// makeGitHubV4HTTPClient returns the raw, unwrapped oauth2 http.Client for GraphQL calls.
+// NewGithubToolHandler wraps its transport with the same rate-limit retry/backoff as the
+// REST client before building the githubv4.Client from it.
+func makeGitHubV4HTTPClient(ctx context.Context) (*http.Client, error) {
+	token := os.Getenv("GITHUB_TOKEN_MCP_APP_REVIEW")
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	return oauth2.NewClient(ctx, ts), nil
+}
+
+func registerTools(ctx context.Context, server *mcp.Server, forgeClient forge.Client) error {
 	log.Println("Registering tool: list_my_pull_requests")
 
 	if err := server.RegisterTool("list_my_pull_requests", "List my pull requests",
 		func(arguments gh.PRListRequest) (*mcp.ToolResponse, error) {
-			prs, err := githubToolHandler.ListMyOpenPullRequestsAcrossRepos(ctx)
+			prs, err := forgeClient.ListMyOpenPRs(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("could not list open PRs: %w", err)
 			}
@@ -100,7 +196,7 @@ func registerTools(ctx context.Context, server *mcp.Server, githubToolHandler *g
 
 	if err := server.RegisterTool("get_pr_content", "Get content of a pull request",
 		func(arguments gh.PRReviewRequest) (*mcp.ToolResponse, error) {
-			content, err := githubToolHandler.GetPullRequestContents(ctx, arguments.PRURL)
+			content, err := forgeClient.GetPRContents(ctx, arguments.PRURL)
 			if err != nil {
 				return nil, fmt.Errorf("could not get PR content: %w", err)
 			}
@@ -118,7 +214,7 @@ func registerTools(ctx context.Context, server *mcp.Server, githubToolHandler *g
 
 	if err := server.RegisterTool("review_pr", "Submit a review on a pull request",
 		func(arguments gh.PRReviewSubmitRequest) (*mcp.ToolResponse, error) {
-			review, err := githubToolHandler.SubmitPullRequestReview(ctx, arguments.PRURL, arguments.ReviewBody)
+			review, err := forgeClient.SubmitReview(ctx, arguments.PRURL, arguments.ReviewBody, nil)
 			if err != nil {
 				return nil, fmt.Errorf("could not submit PR review: %w", err)
 			}
@@ -131,5 +227,113 @@ func registerTools(ctx context.Context, server *mcp.Server, githubToolHandler *g
 		}); err != nil {
 		return fmt.Errorf("could not register review_pr tool: %w", err)
 	}
+
+	log.Println("Registering tool: diff_pr")
+
+	if err := server.RegisterTool("diff_pr", "Get a parsed, line-anchored diff for a pull request",
+		func(arguments gh.PRDiffRequest) (*mcp.ToolResponse, error) {
+			githubToolHandler, ok := forgeClient.(*gh.GithubToolHandler)
+			if !ok {
+				return nil, fmt.Errorf("diff_pr is only supported on the github forge backend")
+			}
+
+			diff, err := githubToolHandler.GetPullRequestDiff(ctx, arguments.PRURL, arguments.IncludeFullContentForTruncated)
+			if err != nil {
+				return nil, fmt.Errorf("could not get PR diff: %w", err)
+			}
+
+			diffJSON, err := json.Marshal(diff)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal PR diff: %w", err)
+			}
+			return mcp.NewToolResponse(mcp.NewTextContent(string(diffJSON))), nil
+		}); err != nil {
+		return fmt.Errorf("could not register diff_pr tool: %w", err)
+	}
+
+	log.Println("Registering tool: review_pr_inline")
+
+	if err := server.RegisterTool("review_pr_inline", "Submit a review on a pull request with per-file, per-line comments",
+		func(arguments gh.PRReviewInlineRequest) (*mcp.ToolResponse, error) {
+			review, err := forgeClient.SubmitReview(ctx, arguments.PRURL, arguments.ReviewBody, &forge.ReviewOptions{
+				Event:    arguments.Event,
+				Comments: inlineCommentsToForge(arguments.Comments),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not submit inline PR review: %w", err)
+			}
+
+			reviewJSON, err := json.Marshal(review)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal PR review: %w", err)
+			}
+			return mcp.NewToolResponse(mcp.NewTextContent(string(reviewJSON))), nil
+		}); err != nil {
+		return fmt.Errorf("could not register review_pr_inline tool: %w", err)
+	}
+
+	log.Println("Registering tool: save_draft_review")
+
+	if err := server.RegisterTool("save_draft_review", "Save a draft review for a pull request without submitting it",
+		func(arguments gh.SaveDraftReviewRequest) (*mcp.ToolResponse, error) {
+			githubToolHandler, ok := forgeClient.(*gh.GithubToolHandler)
+			if !ok {
+				return nil, fmt.Errorf("save_draft_review is only supported on the github forge backend")
+			}
+
+			draft, err := githubToolHandler.SaveDraftReview(ctx, arguments.PRURL, arguments.Body)
+			if err != nil {
+				return nil, fmt.Errorf("could not save draft review: %w", err)
+			}
+
+			draftJSON, err := json.Marshal(draft)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal draft review: %w", err)
+			}
+			return mcp.NewToolResponse(mcp.NewTextContent(string(draftJSON))), nil
+		}); err != nil {
+		return fmt.Errorf("could not register save_draft_review tool: %w", err)
+	}
+
+	log.Println("Registering tool: list_draft_reviews")
+
+	if err := server.RegisterTool("list_draft_reviews", "List saved draft reviews for a pull request",
+		func(arguments gh.ListDraftReviewsRequest) (*mcp.ToolResponse, error) {
+			githubToolHandler, ok := forgeClient.(*gh.GithubToolHandler)
+			if !ok {
+				return nil, fmt.Errorf("list_draft_reviews is only supported on the github forge backend")
+			}
+
+			drafts, err := githubToolHandler.ListDraftReviews(ctx, arguments.PRURL)
+			if err != nil {
+				return nil, fmt.Errorf("could not list draft reviews: %w", err)
+			}
+
+			draftsJSON, err := json.Marshal(drafts)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal draft reviews: %w", err)
+			}
+			return mcp.NewToolResponse(mcp.NewTextContent(string(draftsJSON))), nil
+		}); err != nil {
+		return fmt.Errorf("could not register list_draft_reviews tool: %w", err)
+	}
 	return nil
 }
+
+func inlineCommentsToForge(comments []gh.InlineComment) []forge.InlineComment {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	forgeComments := make([]forge.InlineComment, 0, len(comments))
+	for _, c := range comments {
+		forgeComments = append(forgeComments, forge.InlineComment{
+			Path:      c.Path,
+			Line:      c.Line,
+			Side:      c.Side,
+			StartLine: c.StartLine,
+			Body:      c.Body,
+		})
+	}
+	return forgeComments
+}
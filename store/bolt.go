@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	prContentBucket        = []byte("pr_content")
+	draftReviewsBucket     = []byte("draft_reviews")
+	submittedReviewsBucket = []byte("submitted_reviews")
+)
+
+// BoltStore is the default ReviewStore implementation, backed by a single BoltDB file.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+var _ ReviewStore = (*BoltStore)(nil)
+
+// NewBoltStore opens (creating if necessary) a BoltDB-backed ReviewStore at path.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt store at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{prContentBucket, draftReviewsBucket, submittedReviewsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return fmt.Errorf("failed to create bucket %s: %w", bucket, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// GetPRContent implements ReviewStore.
+func (s *BoltStore) GetPRContent(ctx context.Context, key string) ([]byte, bool, error) {
+	var content []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(prContentBucket).Get([]byte(key)); v != nil {
+			content = append([]byte(nil), v...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cached PR content for %s: %w", key, err)
+	}
+	return content, content != nil, nil
+}
+
+// PutPRContent implements ReviewStore.
+func (s *BoltStore) PutPRContent(ctx context.Context, key string, content []byte) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(prContentBucket).Put([]byte(key), content)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cache PR content for %s: %w", key, err)
+	}
+	return nil
+}
+
+// SaveDraftReview implements ReviewStore.
+func (s *BoltStore) SaveDraftReview(ctx context.Context, draft DraftReview) error {
+	encoded, err := json.Marshal(draft)
+	if err != nil {
+		return fmt.Errorf("failed to encode draft review: %w", err)
+	}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(draftReviewsBucket).CreateBucketIfNotExists([]byte(draft.PRKey))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(draft.ID), encoded)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save draft review %s: %w", draft.ID, err)
+	}
+	return nil
+}
+
+// ListDraftReviews implements ReviewStore.
+func (s *BoltStore) ListDraftReviews(ctx context.Context, prKey string) ([]DraftReview, error) {
+	var drafts []DraftReview
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(draftReviewsBucket).Bucket([]byte(prKey))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(_, v []byte) error {
+			var draft DraftReview
+			if err := json.Unmarshal(v, &draft); err != nil {
+				return fmt.Errorf("failed to decode draft review: %w", err)
+			}
+			drafts = append(drafts, draft)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft reviews for %s: %w", prKey, err)
+	}
+
+	// Bucket keys are content hashes (see gh.draftReviewID), so ForEach's lexicographic
+	// order has nothing to do with save order. Sort by CreatedAt to honor "oldest first".
+	sort.Slice(drafts, func(i, j int) bool {
+		return drafts[i].CreatedAt.Before(drafts[j].CreatedAt)
+	})
+	return drafts, nil
+}
+
+// WasReviewSubmitted implements ReviewStore.
+func (s *BoltStore) WasReviewSubmitted(ctx context.Context, key string) (bool, error) {
+	var submitted bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		submitted = tx.Bucket(submittedReviewsBucket).Get([]byte(key)) != nil
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check submitted review %s: %w", key, err)
+	}
+	return submitted, nil
+}
+
+// RecordReviewSubmitted implements ReviewStore.
+func (s *BoltStore) RecordReviewSubmitted(ctx context.Context, key string) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(submittedReviewsBucket).Put([]byte(key), []byte{1})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record submitted review %s: %w", key, err)
+	}
+	return nil
+}
+
+// Close implements ReviewStore.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
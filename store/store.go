@@ -0,0 +1,45 @@
+// Package store persists review session state (fetched PR content and in-progress draft
+// reviews) across gh-self-reviewer runs, so a multi-turn agent doesn't re-fetch PR content
+// that hasn't changed or resubmit a review it already posted.
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// DraftReview is a review body the LLM has produced but not yet submitted to the forge,
+// scoped to a single PR.
+type DraftReview struct {
+	ID        string    `json:"id"`
+	PRKey     string    `json:"pr_key"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ReviewStore caches PR content and draft reviews across runs. Callers own the key
+// scheme: PR content is conventionally keyed as "owner/repo/number@headSHA" so a change to
+// the head SHA naturally invalidates the cache entry.
+type ReviewStore interface {
+	// GetPRContent returns the cached content JSON for key, or ok=false if absent.
+	GetPRContent(ctx context.Context, key string) (content []byte, ok bool, err error)
+
+	// PutPRContent caches content JSON under key.
+	PutPRContent(ctx context.Context, key string, content []byte) error
+
+	// SaveDraftReview stores a draft review under its PR key.
+	SaveDraftReview(ctx context.Context, draft DraftReview) error
+
+	// ListDraftReviews returns every draft review saved for prKey, oldest first.
+	ListDraftReviews(ctx context.Context, prKey string) ([]DraftReview, error)
+
+	// WasReviewSubmitted reports whether a review keyed by key has already been recorded
+	// as submitted, so callers can skip resubmitting it.
+	WasReviewSubmitted(ctx context.Context, key string) (bool, error)
+
+	// RecordReviewSubmitted marks key as submitted.
+	RecordReviewSubmitted(ctx context.Context, key string) error
+
+	// Close releases any resources held by the store.
+	Close() error
+}